@@ -0,0 +1,57 @@
+package app
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+
+	lazyledgerante "github.com/lazyledger/lazyledger-app/x/lazyledgerapp/ante"
+	lazyledgerkeeper "github.com/lazyledger/lazyledger-app/x/lazyledgerapp/keeper"
+)
+
+// HandlerOptions extends the standard auth ante.HandlerOptions with the
+// lazyledgerapp keeper the PFB decorator needs.
+type HandlerOptions struct {
+	authante.HandlerOptions
+
+	LazyledgerKeeper lazyledgerkeeper.Keeper
+}
+
+// NewAnteHandler returns the app's AnteHandler: the standard cosmos-sdk
+// decorator chain, with lazyledgerante.PreprocessCacheDecorator appended so
+// every PFB is charged gas at the keeper's governance-controlled
+// GasPerBlobByte rate (not appconsts.DefaultGasPerBlobByte) and recorded for
+// the invariants in x/lazyledgerapp/keeper/invariants.go to check.
+func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
+	if options.AccountKeeper == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "account keeper is required for ante builder")
+	}
+	if options.BankKeeper == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "bank keeper is required for ante builder")
+	}
+	if options.SignModeHandler == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "sign mode handler is required for ante builder")
+	}
+
+	sigGasConsumer := options.SigGasConsumer
+	if sigGasConsumer == nil {
+		sigGasConsumer = authante.DefaultSigVerificationGasConsumer
+	}
+
+	return sdk.ChainAnteDecorators(
+		authante.NewSetUpContextDecorator(),
+		authante.NewRejectExtensionOptionsDecorator(),
+		authante.NewMempoolFeeDecorator(),
+		authante.NewValidateBasicDecorator(),
+		authante.NewTxTimeoutHeightDecorator(),
+		authante.NewValidateMemoDecorator(options.AccountKeeper),
+		authante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
+		authante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper),
+		authante.NewSetPubKeyDecorator(options.AccountKeeper),
+		authante.NewValidateSigCountDecorator(options.AccountKeeper),
+		authante.NewSigGasConsumeDecorator(options.AccountKeeper, sigGasConsumer),
+		authante.NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler),
+		authante.NewIncrementSequenceDecorator(options.AccountKeeper),
+		lazyledgerante.NewPreprocessCacheDecorator(options.LazyledgerKeeper),
+	), nil
+}
@@ -0,0 +1,69 @@
+package app
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/lazyledger/lazyledger-app/x/lazyledgerapp/keeper"
+	"github.com/lazyledger/lazyledger-app/x/lazyledgerapp/types"
+	abci "github.com/lazyledger/lazyledger-core/abci/types"
+)
+
+// RegisterLazyledgerQueryService registers the lazyledgerapp.v1 Query
+// service. PreprocessTxs needs App.PreprocessTxs, which x/lazyledgerapp's
+// AppModule can't reach without an app -> x/lazyledgerapp -> app import
+// cycle, so - unlike every other module's Query service - this one is
+// registered directly from App.RegisterGRPCServices instead of through
+// AppModule.RegisterServices (see x/lazyledgerapp/module.go).
+func (app *App) RegisterLazyledgerQueryService(cfg module.Configurator) {
+	types.RegisterQueryServer(cfg.QueryServer(), newQueryServer(app, app.LazyledgerKeeper))
+}
+
+// queryServer implements the full lazyledgerapp.v1 Query service: Params
+// and SquareSize delegate to keeper.NewQuerier, and PreprocessTxs needs the
+// App itself, since it runs the same square-packing logic PreprocessTxs
+// uses at block-proposal time, invoked read-only against a candidate tx
+// set. A single type is needed here, rather than two half-implementations,
+// because types.RegisterQueryServer registers one QueryServer for the whole
+// service.
+type queryServer struct {
+	keeper keeper.Keeper
+	app    *App
+}
+
+// newQueryServer returns the lazyledgerapp.v1 Query service implementation
+// registered by App.RegisterGRPCServices.
+func newQueryServer(app *App, k keeper.Keeper) queryServer {
+	return queryServer{keeper: k, app: app}
+}
+
+// Params implements the Query/Params gRPC method.
+func (q queryServer) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	return keeper.NewQuerier(q.keeper).Params(c, req)
+}
+
+// SquareSize implements the Query/SquareSize gRPC method.
+func (q queryServer) SquareSize(c context.Context, req *types.QuerySquareSizeRequest) (*types.QuerySquareSizeResponse, error) {
+	return keeper.NewQuerier(q.keeper).SquareSize(c, req)
+}
+
+// PreprocessTxs implements the Query/PreprocessTxs gRPC method: it runs
+// App.PreprocessTxs on the request's txs. The request context is not used;
+// App.PreprocessTxs reads the params through its own read-only context and
+// writes no state.
+func (q queryServer) PreprocessTxs(c context.Context, req *types.QueryPreprocessTxsRequest) (*types.QueryPreprocessTxsResponse, error) {
+	_ = sdk.UnwrapSDKContext(c)
+
+	res := q.app.PreprocessTxs(abci.RequestPreprocessTxs{Txs: req.Txs})
+
+	messagesBz, err := res.Messages.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPreprocessTxsResponse{
+		Txs:      res.Txs,
+		Messages: messagesBz,
+	}, nil
+}
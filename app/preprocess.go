@@ -0,0 +1,139 @@
+package app
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lazyledger/lazyledger-app/pkg/appconsts"
+	"github.com/lazyledger/lazyledger-app/x/lazyledgerapp/types"
+	abci "github.com/lazyledger/lazyledger-core/abci/types"
+	tmproto "github.com/lazyledger/lazyledger-core/proto/tendermint/types"
+)
+
+// minMessageShareSize is the smallest number of bytes a non-empty message is
+// padded up to - half a share, so a lone byte of blob data still reserves
+// enough of the square that the non-interactive default rules (a message
+// starts on a share index divisible by its padded length, rounded to a
+// power of two) have room to apply.
+const minMessageShareSize = appconsts.ShareSize / 2
+
+// SquareSize returns the current effective max square size: the
+// governance-controlled lazyledgerapp Params value (see
+// keeper.Keeper.MaxSquareSize), not the compile-time
+// appconsts.DefaultMaxSquareSize the chain launched with. PreprocessTxs below
+// packs against this same value, so the SquareSize query RPC
+// (app/grpc_query.go) never diverges from what the chain actually does.
+func (app *App) SquareSize() uint64 {
+	return app.LazyledgerKeeper.MaxSquareSize(app.preprocessContext())
+}
+
+// processMsg converts a single PFB message into the tmproto.Message the square
+// packs, charging the same keeper-governed GasPerBlobByte rate the PFB ante
+// decorator uses (x/lazyledgerapp/ante/preprocess_decorator.go), so the two
+// gas computations can never drift apart.
+func (app *App) processMsg(msg sdk.Msg) (tmproto.Message, uint64, error) {
+	pfb, ok := msg.(*types.MsgWirePayForMessage)
+	if !ok {
+		return tmproto.Message{}, 0, fmt.Errorf("unsupported message type for square packing: %T", msg)
+	}
+
+	gasUsed := pfb.MessageSize * app.LazyledgerKeeper.GasPerBlobByte(app.preprocessContext())
+	return tmproto.Message{NamespaceId: pfb.MessageNameSpaceId, Data: pfb.Message}, gasUsed, nil
+}
+
+// PreprocessTxs decodes each candidate tx, extracts its PFB message, pads and
+// namespace-sorts the resulting messages, and drops whatever doesn't fit in
+// the keeper's governance-controlled square (not appconsts.MaxShareCount),
+// so a passed MsgUpdateParams that widens the square takes effect here too,
+// not just in the gas charge and the invariants.
+func (app *App) PreprocessTxs(req abci.RequestPreprocessTxs) abci.ResponsePreprocessTxs {
+	ctx := app.preprocessContext()
+	squareSize := app.LazyledgerKeeper.MaxSquareSize(ctx)
+	maxShareCount := squareSize * squareSize
+
+	decoder := app.txConfig.TxDecoder()
+
+	type packedTx struct {
+		message *tmproto.Message
+		rawTx   []byte
+	}
+	packed := make([]packedTx, 0, len(req.Txs))
+
+	for _, rawTx := range req.Txs {
+		tx, err := decoder(rawTx)
+		if err != nil {
+			continue
+		}
+
+		for _, msg := range tx.GetMsgs() {
+			message, _, err := app.processMsg(msg)
+			if err != nil {
+				continue
+			}
+			message.Data = padMessage(message.Data)
+			packed = append(packed, packedTx{message: &message, rawTx: rawTx})
+		}
+	}
+
+	sort.SliceStable(packed, func(i, j int) bool {
+		return bytes.Compare(packed[i].message.NamespaceId, packed[j].message.NamespaceId) < 0
+	})
+
+	var shareCount uint64
+	messages := make([]*tmproto.Message, 0, len(packed))
+	txs := make([][]byte, 0, len(packed))
+	for _, p := range packed {
+		shareCount += uint64(len(p.message.Data)) / appconsts.ShareSize
+		if shareCount > maxShareCount {
+			break
+		}
+		messages = append(messages, p.message)
+		txs = append(txs, p.rawTx)
+	}
+
+	return abci.ResponsePreprocessTxs{
+		Txs:      txs,
+		Messages: &tmproto.Messages{MessagesList: messages},
+	}
+}
+
+// preprocessContext returns a read-only sdk.Context against the app's
+// current committed state, for the keeper lookups SquareSize, processMsg and
+// PreprocessTxs need outside of any request-scoped ctx (they're called
+// directly by the consensus engine, not through a decorator or gRPC
+// handler that already has one).
+func (app *App) preprocessContext() sdk.Context {
+	return app.NewContext(true, tmproto.Header{})
+}
+
+// padMessage pads a non-empty message up to the next power of two bytes, no
+// smaller than minMessageShareSize, so it can start on a square index
+// divisible by its own length per the non-interactive default rules. An
+// empty message is left as-is.
+func padMessage(data []byte) []byte {
+	if len(data) == 0 {
+		return data
+	}
+
+	target := nextPowerOfTwo(len(data))
+	if target < minMessageShareSize {
+		target = minMessageShareSize
+	}
+	if target == len(data) {
+		return data
+	}
+
+	padded := make([]byte, target)
+	copy(padded, data)
+	return padded
+}
+
+func nextPowerOfTwo(n int) int {
+	p := 1
+	for p < n {
+		p <<= 1
+	}
+	return p
+}
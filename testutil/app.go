@@ -0,0 +1,135 @@
+package testutil
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/lazyledger/lazyledger-app/app"
+	abci "github.com/lazyledger/lazyledger-core/abci/types"
+	"github.com/lazyledger/lazyledger-core/libs/log"
+	"github.com/spf13/cast"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// NewTestApp bootstraps a lazyledger App backed by an in-memory DB, funds a
+// freshly generated account with "token" and "stake" balances, and runs
+// InitChain against it. It is the shared entrypoint for any test, in this
+// module or downstream, that needs a ready-to-use App instead of wiring up
+// genesis by hand.
+func NewTestApp(t *testing.T) (*app.App, *secp256k1.PrivKey) {
+	emptyOpts := emptyAppOptions{}
+	var anteOpt = func(bapp *baseapp.BaseApp) { bapp.SetAnteHandler(nil) }
+	db := dbm.NewMemDB()
+	logger := log.NewTMLogger(log.NewSyncWriter(os.Stderr))
+
+	skipUpgradeHeights := make(map[int64]bool)
+
+	testApp := app.New(
+		"test-app", logger, db, nil, true, skipUpgradeHeights,
+		cast.ToString(emptyOpts.Get(flags.FlagHome)),
+		cast.ToUint(emptyOpts.Get(server.FlagInvCheckPeriod)),
+		app.MakeEncodingConfig(),
+		emptyOpts,
+		anteOpt,
+	)
+
+	key := secp256k1.GenPrivKey()
+
+	genesisState := app.NewDefaultGenesisState()
+
+	genesisState, err := addGenesisAccount(sdk.AccAddress(key.PubKey().Address().Bytes()), genesisState, testApp.AppCodec())
+	require.NoError(t, err)
+
+	stateBytes, err := json.MarshalIndent(genesisState, "", "  ")
+	require.NoError(t, err)
+
+	testApp.InitChain(
+		abci.RequestInitChain{
+			Validators:    []abci.ValidatorUpdate{},
+			AppStateBytes: stateBytes,
+		},
+	)
+
+	return testApp, key
+}
+
+type emptyAppOptions struct{}
+
+// Get implements AppOptions
+func (ao emptyAppOptions) Get(o string) interface{} {
+	return nil
+}
+
+// addGenesisAccount mimics the cli addGenesisAccount command, providing an
+// account with an allocation of "token" and "stake" tokens in the genesis
+// state.
+func addGenesisAccount(addr sdk.AccAddress, appState map[string]json.RawMessage, cdc codec.Marshaler) (map[string]json.RawMessage, error) {
+	// create concrete account type based on input parameters
+	var genAccount authtypes.GenesisAccount
+
+	coins := sdk.Coins{
+		sdk.NewCoin("token", sdk.NewInt(1000000)),
+		sdk.NewCoin("stake", sdk.NewInt(1000000)),
+	}
+
+	balances := banktypes.Balance{Address: addr.String(), Coins: coins.Sort()}
+	baseAccount := authtypes.NewBaseAccount(addr, nil, 0, 0)
+
+	genAccount = baseAccount
+
+	if err := genAccount.Validate(); err != nil {
+		return appState, fmt.Errorf("failed to validate new genesis account: %w", err)
+	}
+
+	authGenState := authtypes.GetGenesisStateFromAppState(cdc, appState)
+
+	accs, err := authtypes.UnpackAccounts(authGenState.Accounts)
+	if err != nil {
+		return appState, fmt.Errorf("failed to get accounts from any: %w", err)
+	}
+
+	if accs.Contains(addr) {
+		return appState, fmt.Errorf("cannot add account at existing address %s", addr)
+	}
+
+	// Add the new account to the set of genesis accounts and sanitize the
+	// accounts afterwards.
+	accs = append(accs, genAccount)
+	accs = authtypes.SanitizeGenesisAccounts(accs)
+
+	genAccs, err := authtypes.PackAccounts(accs)
+	if err != nil {
+		return appState, fmt.Errorf("failed to convert accounts into any's: %w", err)
+	}
+	authGenState.Accounts = genAccs
+
+	authGenStateBz, err := cdc.MarshalJSON(&authGenState)
+	if err != nil {
+		return appState, fmt.Errorf("failed to marshal auth genesis state: %w", err)
+	}
+
+	appState[authtypes.ModuleName] = authGenStateBz
+
+	bankGenState := banktypes.GetGenesisStateFromAppState(cdc, appState)
+	bankGenState.Balances = append(bankGenState.Balances, balances)
+	bankGenState.Balances = banktypes.SanitizeGenesisBalances(bankGenState.Balances)
+
+	bankGenStateBz, err := cdc.MarshalJSON(bankGenState)
+	if err != nil {
+		return appState, fmt.Errorf("failed to marshal bank genesis state: %w", err)
+	}
+
+	appState[banktypes.ModuleName] = bankGenStateBz
+	return appState, nil
+}
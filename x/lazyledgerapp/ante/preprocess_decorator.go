@@ -0,0 +1,55 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	core "github.com/lazyledger/lazyledger-core/proto/tendermint/types"
+
+	"github.com/lazyledger/lazyledger-app/x/lazyledgerapp/keeper"
+	"github.com/lazyledger/lazyledger-app/x/lazyledgerapp/types"
+)
+
+// PreprocessCacheDecorator is the PFB ante decorator the chunk0-3 review
+// asked for: it charges gas for every MsgWirePayForMessage in the tx at the
+// keeper's governance-controlled GasPerBlobByte rate (replacing
+// appconsts.DefaultGasPerBlobByte), and buffers the resulting message so the
+// invariants in keeper/invariants.go have a real, non-appconsts-derived
+// result to check once AppModule.EndBlock flushes the buffer.
+//
+// It must run after the standard fee/signature decorators (which already
+// charge gas for the tx's byte size) and only adds the PFB-specific blob
+// charge on top.
+type PreprocessCacheDecorator struct {
+	keeper keeper.Keeper
+}
+
+// NewPreprocessCacheDecorator returns a PreprocessCacheDecorator for the
+// provided Keeper.
+func NewPreprocessCacheDecorator(k keeper.Keeper) PreprocessCacheDecorator {
+	return PreprocessCacheDecorator{keeper: k}
+}
+
+func (d PreprocessCacheDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	gasPerByte := d.keeper.GasPerBlobByte(ctx)
+
+	for _, msg := range tx.GetMsgs() {
+		pfb, ok := msg.(*types.MsgWirePayForMessage)
+		if !ok {
+			continue
+		}
+
+		gasUsed := pfb.MessageSize * gasPerByte
+		ctx.GasMeter().ConsumeGas(gasUsed, "pay-for-blob bytes")
+
+		// Only delivered PFBs belong to the block being built; CheckTx and
+		// simulation runs are charged gas but not buffered.
+		if !ctx.IsCheckTx() && !simulate {
+			d.keeper.AppendPFBMessage(
+				&core.Message{NamespaceId: pfb.MessageNameSpaceId, Data: pfb.Message},
+				ctx.TxBytes(),
+				gasUsed,
+			)
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
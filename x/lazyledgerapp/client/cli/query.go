@@ -0,0 +1,143 @@
+package cli
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/lazyledger/lazyledger-app/x/lazyledgerapp/types"
+)
+
+// GetQueryCmd returns the parent `query lazyledgerapp` command.
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the lazyledgerapp module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		CmdQueryParams(),
+		CmdQuerySquareSize(),
+		CmdQueryPreprocessTxs(),
+	)
+
+	return cmd
+}
+
+// CmdQueryParams implements `query lazyledgerapp params`.
+func CmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Query the current lazyledgerapp params",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintObjectLegacy(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdQuerySquareSize implements `query lazyledgerapp square-size`.
+func CmdQuerySquareSize() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "square-size",
+		Short: "Query the current effective square size and share layout constants",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SquareSize(cmd.Context(), &types.QuerySquareSizeRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintObjectLegacy(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdQueryPreprocessTxs implements `query lazyledgerapp preprocess`: it reads
+// a newline-separated list of base64-encoded raw txs from a file and prints
+// the padded, namespace-sorted layout the node would produce for them,
+// without broadcasting anything.
+func CmdQueryPreprocessTxs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "preprocess [path/to/txs.txt]",
+		Short: "Preview how a candidate set of txs would be preprocessed and packed into the square",
+		Long: `Preview how a candidate set of txs would be preprocessed and packed into
+the square. The input file must contain one base64-encoded raw tx per line.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			rawTxs, err := readRawTxsFromFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.PreprocessTxs(cmd.Context(), &types.QueryPreprocessTxsRequest{Txs: rawTxs})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintObjectLegacy(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func readRawTxsFromFile(path string) ([][]byte, error) {
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read txs file: %w", err)
+	}
+
+	var rawTxs [][]byte
+	for _, line := range strings.Split(string(contents), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		rawTx, err := base64.StdEncoding.DecodeString(line)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode tx %q: %w", line, err)
+		}
+		rawTxs = append(rawTxs, rawTx)
+	}
+
+	return rawTxs, nil
+}
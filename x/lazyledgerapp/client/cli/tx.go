@@ -0,0 +1,95 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/spf13/cobra"
+
+	"github.com/lazyledger/lazyledger-app/pkg/appconsts"
+	"github.com/lazyledger/lazyledger-app/x/lazyledgerapp/types"
+)
+
+const flagAuthority = "authority"
+
+// GetTxCmd returns the parent `tx lazyledgerapp` command.
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "lazyledgerapp transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		CmdUpdateParams(),
+	)
+
+	return cmd
+}
+
+// CmdUpdateParams implements `tx lazyledgerapp update-params`: it builds and
+// broadcasts a MsgUpdateParams, using appconsts.SupportedShareVersions for
+// the one param field that isn't a plain number. The message is gov-gated:
+// keeper.MsgServer.UpdateParams rejects any authority other than the gov
+// module account, whatever --authority is set to, so the message only takes
+// effect as the Msg of a passed governance proposal.
+func CmdUpdateParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-params [max-square-size] [min-square-size] [gas-per-blob-byte] [transactions-per-block-limit]",
+		Short: "Submit a MsgUpdateParams to widen or narrow the square without a hard fork",
+		Long: `Submit a MsgUpdateParams to widen or narrow the square without a hard fork.
+This message is gov-gated: it only succeeds when its authority is the
+governance module's account, so it's normally wrapped in a governance
+proposal rather than signed and broadcast directly.`,
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			maxSquareSize, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			minSquareSize, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			gasPerBlobByte, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+			transactionsPerBlockLimit, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			authority, err := cmd.Flags().GetString(flagAuthority)
+			if err != nil {
+				return err
+			}
+			if authority == "" {
+				authority = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+			}
+
+			params := types.NewParams(maxSquareSize, minSquareSize, gasPerBlobByte, transactionsPerBlockLimit, appconsts.SupportedShareVersions)
+			if err := params.Validate(); err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateParams(authority, params)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagAuthority, "", "the address that signs the message, defaults to the gov module account")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,90 @@
+package rest
+
+import (
+	"encoding/base64"
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/gorilla/mux"
+
+	"github.com/lazyledger/lazyledger-app/x/lazyledgerapp/types"
+)
+
+// RegisterRoutes registers the lazyledgerapp module's REST routes, a thin
+// wrapper over the same Query gRPC service the CLI uses, for clients that
+// can't speak gRPC directly.
+func RegisterRoutes(clientCtx client.Context, r *mux.Router) {
+	r.HandleFunc("/lazyledgerapp/v1/params", paramsHandler(clientCtx)).Methods("GET")
+	r.HandleFunc("/lazyledgerapp/v1/square_size", squareSizeHandler(clientCtx)).Methods("GET")
+	r.HandleFunc("/lazyledgerapp/v1/preprocess_txs", preprocessTxsHandler(clientCtx)).Methods("POST")
+}
+
+func paramsHandler(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		clientCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, clientCtx, r)
+		if !ok {
+			return
+		}
+
+		res, err := types.NewQueryClient(clientCtx).Params(r.Context(), &types.QueryParamsRequest{})
+		if rest.CheckInternalServerError(w, err) {
+			return
+		}
+
+		rest.PostProcessResponse(w, clientCtx, res)
+	}
+}
+
+func squareSizeHandler(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		clientCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, clientCtx, r)
+		if !ok {
+			return
+		}
+
+		res, err := types.NewQueryClient(clientCtx).SquareSize(r.Context(), &types.QuerySquareSizeRequest{})
+		if rest.CheckInternalServerError(w, err) {
+			return
+		}
+
+		rest.PostProcessResponse(w, clientCtx, res)
+	}
+}
+
+// preprocessTxsRequest is the REST request body for POST .../preprocess_txs:
+// a JSON array of base64-encoded raw txs, mirroring how every other tx bytes
+// field is encoded over REST.
+type preprocessTxsRequest struct {
+	Txs []string `json:"txs"`
+}
+
+func preprocessTxsHandler(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req preprocessTxsRequest
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		clientCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, clientCtx, r)
+		if !ok {
+			return
+		}
+
+		rawTxs := make([][]byte, len(req.Txs))
+		for i, encoded := range req.Txs {
+			rawTx, err := base64.StdEncoding.DecodeString(encoded)
+			if rest.CheckBadRequestError(w, err) {
+				return
+			}
+			rawTxs[i] = rawTx
+		}
+
+		res, err := types.NewQueryClient(clientCtx).PreprocessTxs(r.Context(), &types.QueryPreprocessTxsRequest{Txs: rawTxs})
+		if rest.CheckInternalServerError(w, err) {
+			return
+		}
+
+		rest.PostProcessResponse(w, clientCtx, res)
+	}
+}
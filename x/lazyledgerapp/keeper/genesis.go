@@ -0,0 +1,19 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lazyledger/lazyledger-app/x/lazyledgerapp/types"
+)
+
+// InitGenesis seeds the param store from the module's GenesisState, the
+// lazyledgerapp-module equivalent of every other params-only module's
+// InitGenesis.
+func InitGenesis(ctx sdk.Context, k Keeper, genState types.GenesisState) {
+	k.SetParams(ctx, genState.Params)
+}
+
+// ExportGenesis returns the module's current params as a GenesisState, for
+// use during `export` and by the import/export simulation.
+func ExportGenesis(ctx sdk.Context, k Keeper) *types.GenesisState {
+	return types.NewGenesisState(k.GetParams(ctx))
+}
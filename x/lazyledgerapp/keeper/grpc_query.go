@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lazyledger/lazyledger-app/pkg/appconsts"
+	"github.com/lazyledger/lazyledger-app/x/lazyledgerapp/types"
+)
+
+// querier implements the Params and SquareSize RPCs of the lazyledgerapp.v1
+// Query service. PreprocessTxs is implemented at the app layer instead,
+// since it needs App.PreprocessTxs rather than just keeper state - see
+// app/grpc_query.go.
+type querier struct {
+	Keeper
+}
+
+// NewQuerier returns an implementation of the Params/SquareSize half of the
+// lazyledgerapp.v1 Query service for the provided Keeper.
+func NewQuerier(k Keeper) querier { //nolint:golint // unexported return matches other keepers' NewQuerier
+	return querier{Keeper: k}
+}
+
+// Params implements the Query/Params gRPC method.
+func (q querier) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryParamsResponse{Params: q.GetParams(ctx)}, nil
+}
+
+// SquareSize implements the Query/SquareSize gRPC method.
+func (q querier) SquareSize(c context.Context, _ *types.QuerySquareSizeRequest) (*types.QuerySquareSizeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QuerySquareSizeResponse{
+		SquareSize:                         q.MaxSquareSize(ctx),
+		ShareSize:                          appconsts.ShareSize,
+		NamespaceSize:                      appconsts.NamespaceSize,
+		FirstSparseShareContentSize:        appconsts.FirstSparseShareContentSize,
+		ContinuationSparseShareContentSize: appconsts.ContinuationSparseShareContentSize,
+	}, nil
+}
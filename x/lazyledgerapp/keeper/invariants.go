@@ -0,0 +1,144 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lazyledger/lazyledger-app/pkg/appconsts"
+	"github.com/lazyledger/lazyledger-app/x/lazyledgerapp/types"
+)
+
+// RegisterInvariants registers every lazyledgerapp invariant with the
+// app-wide crisis keeper, following the same `ir.RegisterRoute(ModuleName,
+// route, invariant)` pattern every other module's keeper uses. It is called
+// by AppModule.RegisterInvariants (see ../module.go), which app.mm's
+// generic `mm.RegisterInvariants(app.CrisisKeeper)` call picks up the same
+// way it does for every other registered module.
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "messages-sorted-and-padded", MessagesSortedAndPaddedInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "share-count", ShareCountInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "share-commitment", ShareCommitmentInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "blob-gas", BlobGasInvariant(k))
+}
+
+// MessagesSortedAndPaddedInvariant checks that the messages produced by the
+// most recent PreprocessTxs are sorted by namespace ID and that each one is
+// padded out to a whole number of ShareSize-sized shares. It is a no-op
+// until the cache has something in it - e.g. at genesis, or before any
+// block has carried a PFB; after a block with no PFBs it re-checks the
+// previous result.
+func MessagesSortedAndPaddedInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		result, ok := k.LastPreprocessResult()
+		if !ok || result.Messages == nil {
+			return "", false
+		}
+		msgs := result.Messages.MessagesList
+
+		for i := 0; i < len(msgs); i++ {
+			if i > 0 && bytesCompare(msgs[i-1].NamespaceId, msgs[i].NamespaceId) > 0 {
+				return sdk.FormatInvariant(types.ModuleName, "messages-sorted-and-padded",
+					fmt.Sprintf("message %d's namespace ID is not lexicographically >= message %d's", i, i-1)), true
+			}
+			if len(msgs[i].Data)%appconsts.ShareSize != 0 {
+				return sdk.FormatInvariant(types.ModuleName, "messages-sorted-and-padded",
+					fmt.Sprintf("message %d is %d bytes, not a whole number of %d-byte shares", i, len(msgs[i].Data), appconsts.ShareSize)), true
+			}
+		}
+
+		return "", false
+	}
+}
+
+// ShareCountInvariant checks that the total number of shares produced by the
+// most recent PreprocessTxs never exceeds the governance-controlled square's
+// share count, MaxSquareSize squared - the same bound PreprocessTxs packs
+// against.
+func ShareCountInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		result, ok := k.LastPreprocessResult()
+		if !ok || result.Messages == nil {
+			return "", false
+		}
+
+		var shareCount uint64
+		for _, msg := range result.Messages.MessagesList {
+			shareCount += uint64(len(msg.Data)) / appconsts.ShareSize
+		}
+
+		squareSize := k.MaxSquareSize(ctx)
+		maxShareCount := squareSize * squareSize
+		if shareCount > maxShareCount {
+			return sdk.FormatInvariant(types.ModuleName, "share-count",
+				fmt.Sprintf("square packed %d shares, exceeding the max of %d", shareCount, maxShareCount)), true
+		}
+
+		return "", false
+	}
+}
+
+// ShareCommitmentInvariant recomputes each accepted PFB's share commitment
+// from its namespace and message bytes, against the governance-controlled
+// square size, and checks that the recomputation succeeds and yields a
+// non-empty commitment. It does not compare the result with the commitment
+// the PFB carried; the cached result does not hold that commitment.
+func ShareCommitmentInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		result, ok := k.LastPreprocessResult()
+		if !ok || result.Messages == nil {
+			return "", false
+		}
+
+		squareSize := k.MaxSquareSize(ctx)
+		for _, msg := range result.Messages.MessagesList {
+			commit, err := types.CreateCommitment(squareSize, msg.NamespaceId, msg.Data)
+			if err != nil {
+				return sdk.FormatInvariant(types.ModuleName, "share-commitment",
+					fmt.Sprintf("unable to recompute commitment for namespace %x: %s", msg.NamespaceId, err)), true
+			}
+			if len(commit) == 0 {
+				return sdk.FormatInvariant(types.ModuleName, "share-commitment",
+					fmt.Sprintf("recomputed an empty commitment for namespace %x", msg.NamespaceId)), true
+			}
+		}
+
+		return "", false
+	}
+}
+
+// BlobGasInvariant checks that the gas charged for each delivered PFB equals
+// the governance-controlled GasPerBlobByte times the length of its message.
+func BlobGasInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		result, ok := k.LastPreprocessResult()
+		if !ok || result.Messages == nil {
+			return "", false
+		}
+		msgs := result.Messages.MessagesList
+
+		if len(result.GasUsed) != len(msgs) {
+			return "", false
+		}
+
+		gasPerByte := k.GasPerBlobByte(ctx)
+		for i, msg := range msgs {
+			want := uint64(len(msg.Data)) * gasPerByte
+			if result.GasUsed[i] != want {
+				return sdk.FormatInvariant(types.ModuleName, "blob-gas",
+					fmt.Sprintf("message %d charged %d gas, want %d (%d bytes * %d gas/byte)",
+						i, result.GasUsed[i], want, len(msg.Data), gasPerByte)), true
+			}
+		}
+
+		return "", false
+	}
+}
+
+func bytesCompare(a, b []byte) int {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		if a[i] != b[i] {
+			return int(a[i]) - int(b[i])
+		}
+	}
+	return len(a) - len(b)
+}
@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/lazyledger/lazyledger-app/x/lazyledgerapp/types"
+)
+
+// Keeper owns the lazyledgerapp module's subspace of the x/params store, the
+// same shape every other cosmos-sdk params-only module (mint, slashing, ...)
+// uses: no KVStoreKey of its own, just a paramSpace handle. It also owns an
+// in-memory (non-consensus) cache of the most recent PreprocessTxs output,
+// populated by the PFB ante decorator and consulted by the invariants in
+// invariants.go - see preprocessCache in preprocess_cache.go.
+type Keeper struct {
+	cdc        codec.BinaryCodec
+	paramSpace paramtypes.Subspace
+	cache      *preprocessCache
+}
+
+// NewKeeper creates a new lazyledgerapp Keeper. If paramSpace has no key
+// table yet, NewKeeper sets types.ParamKeyTable on it, mirroring how every
+// other module's NewKeeper is wired up in app.go.
+func NewKeeper(cdc codec.BinaryCodec, paramSpace paramtypes.Subspace) Keeper {
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		cdc:        cdc,
+		paramSpace: paramSpace,
+		cache:      &preprocessCache{},
+	}
+}
+
+// GetParams returns the full Params blob from the param store.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the full Params blob in the param store. Callers (the
+// genesis initializer and the MsgUpdateParams handler) are responsible for
+// validating params first.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// MaxSquareSize returns the current governance-controlled max square size,
+// replacing the compile-time appconsts.DefaultMaxSquareSize as the value
+// App.SquareSize() and the PreprocessTxs padding logic should consult.
+func (k Keeper) MaxSquareSize(ctx sdk.Context) (res uint64) {
+	k.paramSpace.Get(ctx, types.KeyMaxSquareSize, &res)
+	return res
+}
+
+// MinSquareSize returns the current governance-controlled min square size.
+func (k Keeper) MinSquareSize(ctx sdk.Context) (res uint64) {
+	k.paramSpace.Get(ctx, types.KeyMinSquareSize, &res)
+	return res
+}
+
+// GasPerBlobByte returns the current governance-controlled gas cost per byte
+// of blob data in a PFB, replacing appconsts.DefaultGasPerBlobByte as the
+// value the PFB ante handler's gas meter should consult.
+func (k Keeper) GasPerBlobByte(ctx sdk.Context) (res uint64) {
+	k.paramSpace.Get(ctx, types.KeyGasPerBlobByte, &res)
+	return res
+}
+
+// TransactionsPerBlockLimit returns the current governance-controlled cap on
+// the number of transactions a block producer will include in a block.
+func (k Keeper) TransactionsPerBlockLimit(ctx sdk.Context) (res uint64) {
+	k.paramSpace.Get(ctx, types.KeyTransactionsPerBlockLimit, &res)
+	return res
+}
+
+// SupportedShareVersions returns the current governance-controlled list of
+// supported share versions.
+func (k Keeper) SupportedShareVersions(ctx sdk.Context) (res []uint8) {
+	k.paramSpace.Get(ctx, types.KeySupportedShareVersions, &res)
+	return res
+}
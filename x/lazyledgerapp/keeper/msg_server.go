@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/lazyledger/lazyledger-app/x/lazyledgerapp/types"
+)
+
+// MsgServer is the lazyledgerapp module's Msg handler, following the same
+// shape every other module's keeper/msg_server.go uses.
+type MsgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the lazyledgerapp MsgServer
+// interface for the provided Keeper.
+func NewMsgServerImpl(k Keeper) MsgServer {
+	return MsgServer{Keeper: k}
+}
+
+// UpdateParams applies a new Params blob, but only when the message's
+// authority matches the gov module's account - the same gate every other
+// x/<module> MsgUpdateParams handler uses to require a passed proposal.
+func (k MsgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName)
+	if msg.Authority != govAddr.String() {
+		return nil, sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", govAddr, msg.Authority)
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	k.SetParams(ctx, msg.Params)
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
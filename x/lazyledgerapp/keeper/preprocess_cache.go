@@ -0,0 +1,107 @@
+package keeper
+
+import (
+	"sort"
+	"sync"
+
+	core "github.com/lazyledger/lazyledger-core/proto/tendermint/types"
+)
+
+// PreprocessResult is the subset of an abci.ResponsePreprocessTxs the
+// invariants in invariants.go need: the namespace-sorted, padded messages
+// produced for the block, the re-ordered raw txs, and the gas charged to
+// each one by the PFB ante decorator (see x/lazyledgerapp/ante).
+type PreprocessResult struct {
+	Messages *core.Messages
+	Txs      [][]byte
+	GasUsed  []uint64
+}
+
+// preprocessCache holds the most recent, settled PreprocessResult in
+// memory, plus the in-progress buffer for the block currently being
+// delivered. It is not consensus state - every node computes its own, and
+// it only exists so invariant checks have something real to look at - so a
+// plain mutex-guarded struct is enough; it deliberately doesn't go through
+// the KVStore.
+type preprocessCache struct {
+	mu     sync.RWMutex
+	result *PreprocessResult
+
+	// buffer accumulates one entry per PFB the ante decorator sees during
+	// the block currently being delivered. EndBlock (see ../module.go)
+	// flushes it into result, namespace-sorted, and resets it for the next
+	// block.
+	buffer []bufferedMessage
+}
+
+type bufferedMessage struct {
+	message *core.Message
+	tx      []byte
+	gasUsed uint64
+}
+
+// RecordPreprocessResult replaces the settled result with the given
+// messages, txs and gas directly, bypassing the per-PFB buffer that the PFB
+// ante decorator fills through AppendPFBMessage.
+func (k Keeper) RecordPreprocessResult(messages *core.Messages, txs [][]byte, gasUsed []uint64) {
+	k.cache.mu.Lock()
+	defer k.cache.mu.Unlock()
+	k.cache.result = &PreprocessResult{Messages: messages, Txs: txs, GasUsed: gasUsed}
+}
+
+// LastPreprocessResult returns the most recently recorded PreprocessTxs
+// output. ok is false until the first result has been recorded - e.g. at
+// genesis, or before any block has carried a PFB - and callers must treat
+// that as "nothing to check" rather than deref a nil Messages. A block that
+// carries no PFBs leaves the previous result in place.
+func (k Keeper) LastPreprocessResult() (result PreprocessResult, ok bool) {
+	k.cache.mu.RLock()
+	defer k.cache.mu.RUnlock()
+	if k.cache.result == nil {
+		return PreprocessResult{}, false
+	}
+	return *k.cache.result, true
+}
+
+// AppendPFBMessage buffers one PFB's message, raw tx and charged gas for the
+// block currently being delivered. It's called once per PFB by the ante
+// decorator in x/lazyledgerapp/ante, and flushed into LastPreprocessResult
+// at EndBlock.
+func (k Keeper) AppendPFBMessage(message *core.Message, tx []byte, gasUsed uint64) {
+	k.cache.mu.Lock()
+	defer k.cache.mu.Unlock()
+	k.cache.buffer = append(k.cache.buffer, bufferedMessage{message: message, tx: tx, gasUsed: gasUsed})
+}
+
+// FlushPreprocessBuffer settles the current block's buffered PFBs into
+// LastPreprocessResult, namespace-sorted the same way PreprocessTxs orders
+// them for the square, and resets the buffer for the next block. Called
+// once per block from AppModule.EndBlock.
+func (k Keeper) FlushPreprocessBuffer() {
+	k.cache.mu.Lock()
+	defer k.cache.mu.Unlock()
+
+	if len(k.cache.buffer) == 0 {
+		return
+	}
+
+	sort.SliceStable(k.cache.buffer, func(i, j int) bool {
+		return bytesCompare(k.cache.buffer[i].message.NamespaceId, k.cache.buffer[j].message.NamespaceId) < 0
+	})
+
+	messages := make([]*core.Message, len(k.cache.buffer))
+	txs := make([][]byte, len(k.cache.buffer))
+	gasUsed := make([]uint64, len(k.cache.buffer))
+	for i, buffered := range k.cache.buffer {
+		messages[i] = buffered.message
+		txs[i] = buffered.tx
+		gasUsed[i] = buffered.gasUsed
+	}
+
+	k.cache.result = &PreprocessResult{
+		Messages: &core.Messages{MessagesList: messages},
+		Txs:      txs,
+		GasUsed:  gasUsed,
+	}
+	k.cache.buffer = nil
+}
@@ -0,0 +1,131 @@
+package lazyledgerapp
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/gorilla/mux"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/spf13/cobra"
+
+	abci "github.com/lazyledger/lazyledger-core/abci/types"
+
+	"github.com/lazyledger/lazyledger-app/x/lazyledgerapp/client/cli"
+	"github.com/lazyledger/lazyledger-app/x/lazyledgerapp/client/rest"
+	"github.com/lazyledger/lazyledger-app/x/lazyledgerapp/keeper"
+	"github.com/lazyledger/lazyledger-app/x/lazyledgerapp/types"
+)
+
+var (
+	_ module.AppModule      = AppModule{}
+	_ module.AppModuleBasic = AppModuleBasic{}
+)
+
+// AppModuleBasic implements module.AppModuleBasic for the lazyledgerapp
+// module - the codec/genesis/CLI surface every other module exposes
+// independent of a running keeper.
+type AppModuleBasic struct{}
+
+func (AppModuleBasic) Name() string { return types.ModuleName }
+
+func (AppModuleBasic) RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	types.RegisterCodec(cdc)
+}
+
+func (AppModuleBasic) RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
+	types.RegisterInterfaces(registry)
+}
+
+func (AppModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
+	return cdc.MustMarshalJSON(types.DefaultGenesis())
+}
+
+func (AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, _ client.TxEncodingConfig, bz json.RawMessage) error {
+	var genState types.GenesisState
+	if err := cdc.UnmarshalJSON(bz, &genState); err != nil {
+		return fmt.Errorf("unmarshal %s genesis state: %w", types.ModuleName, err)
+	}
+	return genState.Validate()
+}
+
+func (AppModuleBasic) RegisterRESTRoutes(clientCtx client.Context, r *mux.Router) {
+	rest.RegisterRoutes(clientCtx, r)
+}
+
+func (AppModuleBasic) RegisterGRPCGatewayRoutes(_ client.Context, _ *runtime.ServeMux) {
+	// No grpc-gateway handler is generated for this hand-written service -
+	// clients that need REST use AppModuleBasic.RegisterRESTRoutes instead.
+}
+
+func (AppModuleBasic) GetTxCmd() *cobra.Command { return cli.GetTxCmd() }
+
+func (AppModuleBasic) GetQueryCmd() *cobra.Command { return cli.GetQueryCmd() }
+
+// AppModule implements module.AppModule for the lazyledgerapp module,
+// wiring the keeper into genesis, invariants, routing and the gRPC query
+// service.
+type AppModule struct {
+	AppModuleBasic
+
+	keeper keeper.Keeper
+}
+
+// NewAppModule creates a new AppModule for the lazyledgerapp module. It
+// belongs in app.go's module.NewManager(...) call alongside every other
+// module, which is what makes RegisterInvariants, RegisterServices and
+// InitGenesis/ExportGenesis below actually run.
+func NewAppModule(k keeper.Keeper) AppModule {
+	return AppModule{keeper: k}
+}
+
+// RegisterInvariants registers the module's invariants with the app-wide
+// crisis keeper. app.mm.RegisterInvariants(app.CrisisKeeper), called once
+// from New() for every module in the manager, is what actually exercises
+// keeper.RegisterInvariants instead of leaving it dead code.
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.keeper)
+}
+
+func (am AppModule) Route() sdk.Route {
+	return sdk.NewRoute(types.RouterKey, NewHandler(am.keeper))
+}
+
+func (AppModule) QuerierRoute() string { return types.QuerierRoute }
+
+func (AppModule) LegacyQuerierHandler(_ *codec.LegacyAmino) sdk.Querier { return nil }
+
+// RegisterServices registers the module's gRPC services. Msg handling still
+// goes through the legacy Route()/Handler above. The lazyledgerapp.v1 Query
+// service is registered separately, by App.RegisterGRPCServices calling
+// App.RegisterLazyledgerQueryService (app/grpc_query.go) - its PreprocessTxs
+// RPC needs App.PreprocessTxs, which would otherwise mean this module
+// importing the app package it's registered into.
+func (am AppModule) RegisterServices(cfg module.Configurator) {}
+
+func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, gs json.RawMessage) []abci.ValidatorUpdate {
+	var genState types.GenesisState
+	cdc.MustUnmarshalJSON(gs, &genState)
+	keeper.InitGenesis(ctx, am.keeper, genState)
+	return nil
+}
+
+func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawMessage {
+	return cdc.MustMarshalJSON(keeper.ExportGenesis(ctx, am.keeper))
+}
+
+func (AppModule) ConsensusVersion() uint64 { return 1 }
+
+func (AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
+
+// EndBlock flushes the module's in-memory PreprocessTxs cache for the block
+// that just finished, so the invariants registered above always observe a
+// complete, settled result rather than a partially-accumulated one.
+func (am AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	am.keeper.FlushPreprocessBuffer()
+	return nil
+}
@@ -0,0 +1,38 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/lazyledger/lazyledger-app/pkg/appconsts"
+	"github.com/lazyledger/lazyledger-app/x/lazyledgerapp/types"
+)
+
+// genMaxSquareSize returns a random max square size that is a power of two
+// between 16 and the compiled-in appconsts.DefaultMaxSquareSize, so simulated
+// genesis states regularly diverge from the default Params while staying
+// within a range PreprocessTxs can pack a handful of PFBs into.
+func genMaxSquareSize(r *rand.Rand) uint64 {
+	choices := []uint64{16, 32, 64, appconsts.DefaultMaxSquareSize}
+	return choices[r.Intn(len(choices))]
+}
+
+// RandomizedGenState generates a random GenesisState for the lazyledgerapp
+// module, following the same pattern as the other modules' simulation
+// packages: derive the module's genesis-configurable Params from the shared
+// rand source and marshal them into the genesis JSON under the module's key.
+func RandomizedGenState(simState *module.SimulationState) {
+	params := types.NewParams(
+		genMaxSquareSize(simState.Rand),
+		appconsts.DefaultMinSquareSize,
+		appconsts.DefaultGasPerBlobByte,
+		appconsts.TransactionsPerBlockLimit,
+		appconsts.SupportedShareVersions,
+	)
+
+	fmt.Printf("Selected randomly generated lazyledgerapp params:\n%+v\n", params)
+
+	genesisState := types.NewGenesisState(params)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(genesisState)
+}
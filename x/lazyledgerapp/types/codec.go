@@ -0,0 +1,30 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RegisterCodec registers the lazyledgerapp module's types on the provided
+// legacy Amino codec, needed by MsgUpdateParams.GetSignBytes.
+func RegisterCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgUpdateParams{}, "lazyledgerapp/MsgUpdateParams", nil)
+}
+
+// RegisterInterfaces registers the lazyledgerapp module's interface types
+// with the interface registry, following the same pattern as every other
+// module's RegisterInterfaces.
+func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgUpdateParams{})
+}
+
+var (
+	amino     = codec.NewLegacyAmino()
+	ModuleCdc = codec.NewAminoCodec(amino)
+)
+
+func init() {
+	RegisterCodec(amino)
+	amino.Seal()
+}
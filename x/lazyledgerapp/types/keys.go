@@ -0,0 +1,15 @@
+package types
+
+const (
+	// ModuleName is the name of the lazyledgerapp module.
+	ModuleName = "lazyledgerapp"
+
+	// StoreKey is the store key string for the lazyledgerapp module.
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for the lazyledgerapp module.
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for the lazyledgerapp module.
+	QuerierRoute = ModuleName
+)
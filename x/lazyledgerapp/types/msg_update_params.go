@@ -0,0 +1,408 @@
+package types
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/gogo/protobuf/proto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgUpdateParams = "update_params"
+
+// MsgUpdateParams is a gov-gated message that lets a passed governance
+// proposal update the lazyledgerapp module's Params, e.g. to widen the
+// square size on a chain upgrade without a hard fork. The struct tags and
+// the ProtoMarshaler methods below correspond 1:1 to
+// proto/lazyledgerapp/v1/tx.proto and are normally produced by `make
+// proto-gen`; they're hand-written here so MsgUpdateParams actually
+// satisfies codec.ProtoMarshaler, the same way a generated type would -
+// required by codec.go's registry.RegisterImplementations.
+type MsgUpdateParams struct {
+	// Authority is the address that controls the module, expected to be the
+	// governance module's account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority" yaml:"authority"`
+	// Params is the full param set to apply. All params must be supplied and
+	// pass Params.Validate(); there is no partial update.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params" yaml:"params"`
+}
+
+// NewMsgUpdateParams creates a new MsgUpdateParams instance.
+func NewMsgUpdateParams(authority string, params Params) *MsgUpdateParams {
+	return &MsgUpdateParams{Authority: authority, Params: params}
+}
+
+// MsgUpdateParamsResponse is the (empty) response to a successful
+// MsgUpdateParams.
+type MsgUpdateParamsResponse struct{}
+
+// Route implements sdk.Msg.
+func (msg MsgUpdateParams) Route() string { return RouterKey }
+
+// Type implements sdk.Msg.
+func (msg MsgUpdateParams) Type() string { return TypeMsgUpdateParams }
+
+// GetSigners implements sdk.Msg.
+func (msg MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+// GetSignBytes implements sdk.Msg.
+func (msg MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic implements sdk.Msg.
+func (msg MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address: %s", err)
+	}
+	if err := msg.Params.Validate(); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid params: %s", err)
+	}
+	return nil
+}
+
+func (msg MsgUpdateParams) String() string {
+	return fmt.Sprintf("MsgUpdateParams{Authority: %s, Params: %+v}", msg.Authority, msg.Params)
+}
+
+func (msg *MsgUpdateParams) Reset()    { *msg = MsgUpdateParams{} }
+func (*MsgUpdateParams) ProtoMessage() {}
+
+func (msg *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := msg.Size()
+	dAtA = make([]byte, size)
+	n, err := msg.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (msg *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := msg.Size()
+	return msg.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (msg *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	{
+		size, err := msg.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(msg.Authority) > 0 {
+		i -= len(msg.Authority)
+		copy(dAtA[i:], msg.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(msg.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (msg *MsgUpdateParams) Size() (n int) {
+	if msg == nil {
+		return 0
+	}
+	if l := len(msg.Authority); l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l := msg.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (msg *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: MsgUpdateParams: wrong wireType = %d for field Authority", wireType)
+			}
+			var strLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				strLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if strLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + strLen
+			if postIndex < 0 || postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			msg.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: MsgUpdateParams: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 || postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := msg.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 || iNdEx+skippy < 0 || iNdEx+skippy > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+// MsgUpdateParamsResponse's ProtoMarshaler surface: an empty message still
+// needs every method to satisfy the interface, even though Marshal/Unmarshal
+// have nothing to encode.
+
+func (r *MsgUpdateParamsResponse) Reset()         { *r = MsgUpdateParamsResponse{} }
+func (r *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(r) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+
+func (r *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := r.Size()
+	dAtA = make([]byte, size)
+	n, err := r.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (r *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := r.Size()
+	return r.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (r *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	return len(dAtA), nil
+}
+
+func (r *MsgUpdateParamsResponse) Size() (n int) { return 0 }
+
+func (r *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		skippy, err := skipTx(dAtA[iNdEx:])
+		if err != nil {
+			return err
+		}
+		if skippy < 0 || iNdEx+skippy < 0 || iNdEx+skippy > l {
+			return io.ErrUnexpectedEOF
+		}
+		iNdEx += skippy
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+
+func sovTx(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+
+// skipTx skips over an unrecognized field of any wire type, mirroring the
+// helper protoc-gen-gocosmos emits once per generated file.
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
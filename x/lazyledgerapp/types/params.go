@@ -0,0 +1,455 @@
+package types
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/gogo/protobuf/proto"
+
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/lazyledger/lazyledger-app/pkg/appconsts"
+)
+
+// SquareSize is the default max square size new tests and genesis state
+// build against, mirroring appconsts.DefaultMaxSquareSize. It exists so
+// callers that need a plain uint64 (e.g. test helpers building a
+// MsgWirePayForMessage commitment) don't reach into pkg/appconsts directly
+// for a value that's now genesis/governance-configurable via Params.
+const SquareSize = appconsts.DefaultMaxSquareSize
+
+// Parameter store keys, following the same pattern as every other
+// cosmos-sdk module's x/<module>/types/params.go.
+var (
+	KeyMaxSquareSize             = []byte("MaxSquareSize")
+	KeyMinSquareSize             = []byte("MinSquareSize")
+	KeyGasPerBlobByte            = []byte("GasPerBlobByte")
+	KeyTransactionsPerBlockLimit = []byte("TransactionsPerBlockLimit")
+	KeySupportedShareVersions    = []byte("SupportedShareVersions")
+)
+
+// Params governs the square-packing and share-layout constants that used to
+// be compile-time appconsts. They're genesis-configurable and, via
+// MsgUpdateParams, gated behind governance so a chain upgrade can widen the
+// square without a hard fork. The struct tags and the ProtoMarshaler methods
+// below correspond 1:1 to proto/lazyledgerapp/v1/params.proto and are
+// normally produced by `make proto-gen`; they're hand-written here so Params
+// actually satisfies codec.ProtoMarshaler, the same way a generated type
+// would, rather than just implementing Stringer.
+type Params struct {
+	MaxSquareSize             uint64  `protobuf:"varint,1,opt,name=max_square_size,json=maxSquareSize,proto3" json:"max_square_size" yaml:"max_square_size"`
+	MinSquareSize             uint64  `protobuf:"varint,2,opt,name=min_square_size,json=minSquareSize,proto3" json:"min_square_size" yaml:"min_square_size"`
+	GasPerBlobByte            uint64  `protobuf:"varint,3,opt,name=gas_per_blob_byte,json=gasPerBlobByte,proto3" json:"gas_per_blob_byte" yaml:"gas_per_blob_byte"`
+	TransactionsPerBlockLimit uint64  `protobuf:"varint,4,opt,name=transactions_per_block_limit,json=transactionsPerBlockLimit,proto3" json:"transactions_per_block_limit" yaml:"transactions_per_block_limit"`
+	SupportedShareVersions    []uint8 `protobuf:"bytes,5,opt,name=supported_share_versions,json=supportedShareVersions,proto3" json:"supported_share_versions" yaml:"supported_share_versions"`
+}
+
+// NewParams creates a new Params object.
+func NewParams(
+	maxSquareSize, minSquareSize, gasPerBlobByte, transactionsPerBlockLimit uint64,
+	supportedShareVersions []uint8,
+) Params {
+	return Params{
+		MaxSquareSize:             maxSquareSize,
+		MinSquareSize:             minSquareSize,
+		GasPerBlobByte:            gasPerBlobByte,
+		TransactionsPerBlockLimit: transactionsPerBlockLimit,
+		SupportedShareVersions:    supportedShareVersions,
+	}
+}
+
+// DefaultParams returns Params seeded from the appconsts package, which now
+// only supplies initial defaults rather than hard-coded values.
+func DefaultParams() Params {
+	return NewParams(
+		appconsts.DefaultMaxSquareSize,
+		appconsts.DefaultMinSquareSize,
+		appconsts.DefaultGasPerBlobByte,
+		appconsts.TransactionsPerBlockLimit,
+		appconsts.SupportedShareVersions,
+	)
+}
+
+// ParamKeyTable returns the param key table for the lazyledgerapp module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// ParamSetPairs implements the paramtypes.ParamSet interface.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyMaxSquareSize, &p.MaxSquareSize, validateMaxSquareSize),
+		paramtypes.NewParamSetPair(KeyMinSquareSize, &p.MinSquareSize, validateMinSquareSize),
+		paramtypes.NewParamSetPair(KeyGasPerBlobByte, &p.GasPerBlobByte, validateGasPerBlobByte),
+		paramtypes.NewParamSetPair(KeyTransactionsPerBlockLimit, &p.TransactionsPerBlockLimit, validateTransactionsPerBlockLimit),
+		paramtypes.NewParamSetPair(KeySupportedShareVersions, &p.SupportedShareVersions, validateSupportedShareVersions),
+	}
+}
+
+// Validate checks that the params are sane: the square bounds are a
+// consistent, positive range, the gas per blob byte and transactions per
+// block limit are positive, and at least one share version is supported.
+func (p Params) Validate() error {
+	if err := validateMaxSquareSize(p.MaxSquareSize); err != nil {
+		return err
+	}
+	if err := validateMinSquareSize(p.MinSquareSize); err != nil {
+		return err
+	}
+	if p.MinSquareSize > p.MaxSquareSize {
+		return fmt.Errorf("min square size %d cannot exceed max square size %d", p.MinSquareSize, p.MaxSquareSize)
+	}
+	if err := validateGasPerBlobByte(p.GasPerBlobByte); err != nil {
+		return err
+	}
+	if err := validateTransactionsPerBlockLimit(p.TransactionsPerBlockLimit); err != nil {
+		return err
+	}
+	return validateSupportedShareVersions(p.SupportedShareVersions)
+}
+
+func validateMaxSquareSize(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 {
+		return fmt.Errorf("max square size must be positive: %d", v)
+	}
+	return nil
+}
+
+func validateMinSquareSize(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 {
+		return fmt.Errorf("min square size must be positive: %d", v)
+	}
+	return nil
+}
+
+func validateGasPerBlobByte(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 {
+		return fmt.Errorf("gas per blob byte must be positive: %d", v)
+	}
+	return nil
+}
+
+func validateTransactionsPerBlockLimit(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 {
+		return fmt.Errorf("transactions per block limit must be positive: %d", v)
+	}
+	return nil
+}
+
+func validateSupportedShareVersions(i interface{}) error {
+	v, ok := i.([]uint8)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if len(v) == 0 {
+		return fmt.Errorf("supported share versions cannot be empty")
+	}
+	return nil
+}
+
+func (p *Params) Reset()         { *p = Params{} }
+func (p *Params) String() string { return proto.CompactTextString(p) }
+func (*Params) ProtoMessage()    {}
+
+// Marshal, MarshalTo, MarshalToSizedBuffer, Size and Unmarshal below
+// implement codec.ProtoMarshaler's wire-format surface by hand, writing and
+// reading the same varint/length-delimited encoding protoc-gen-gocosmos
+// would generate for the field numbers in
+// proto/lazyledgerapp/v1/params.proto.
+
+func (p *Params) Marshal() (dAtA []byte, err error) {
+	size := p.Size()
+	dAtA = make([]byte, size)
+	n, err := p.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (p *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := p.Size()
+	return p.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (p *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if len(p.SupportedShareVersions) > 0 {
+		i -= len(p.SupportedShareVersions)
+		copy(dAtA[i:], p.SupportedShareVersions)
+		i = encodeVarintParams(dAtA, i, uint64(len(p.SupportedShareVersions)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if p.TransactionsPerBlockLimit != 0 {
+		i = encodeVarintParams(dAtA, i, p.TransactionsPerBlockLimit)
+		i--
+		dAtA[i] = 0x20
+	}
+	if p.GasPerBlobByte != 0 {
+		i = encodeVarintParams(dAtA, i, p.GasPerBlobByte)
+		i--
+		dAtA[i] = 0x18
+	}
+	if p.MinSquareSize != 0 {
+		i = encodeVarintParams(dAtA, i, p.MinSquareSize)
+		i--
+		dAtA[i] = 0x10
+	}
+	if p.MaxSquareSize != 0 {
+		i = encodeVarintParams(dAtA, i, p.MaxSquareSize)
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (p *Params) Size() (n int) {
+	if p == nil {
+		return 0
+	}
+	if p.MaxSquareSize != 0 {
+		n += 1 + sovParams(p.MaxSquareSize)
+	}
+	if p.MinSquareSize != 0 {
+		n += 1 + sovParams(p.MinSquareSize)
+	}
+	if p.GasPerBlobByte != 0 {
+		n += 1 + sovParams(p.GasPerBlobByte)
+	}
+	if p.TransactionsPerBlockLimit != 0 {
+		n += 1 + sovParams(p.TransactionsPerBlockLimit)
+	}
+	if l := len(p.SupportedShareVersions); l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	return n
+}
+
+func (p *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1, 2, 3, 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: Params: wrong wireType = %d for field %d", wireType, fieldNum)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			switch fieldNum {
+			case 1:
+				p.MaxSquareSize = v
+			case 2:
+				p.MinSquareSize = v
+			case 3:
+				p.GasPerBlobByte = v
+			case 4:
+				p.TransactionsPerBlockLimit = v
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: Params: wrong wireType = %d for field SupportedShareVersions", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 || postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			p.SupportedShareVersions = append(p.SupportedShareVersions[:0], dAtA[iNdEx:postIndex]...)
+			iNdEx = postIndex
+		default:
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 || iNdEx+skippy < 0 || iNdEx+skippy > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+
+func sovParams(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+
+// skipParams skips over an unrecognized field of any wire type, mirroring
+// the helper protoc-gen-gocosmos emits once per generated file.
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
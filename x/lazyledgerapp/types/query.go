@@ -0,0 +1,662 @@
+package types
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/gogo/protobuf/proto"
+)
+
+// The request/response types below correspond 1:1 to
+// proto/lazyledgerapp/v1/query.proto and are normally produced by `make
+// proto-gen`; they're hand-written here, struct tags and all, so each one
+// actually satisfies codec.ProtoMarshaler - not just Stringer - the same way
+// a generated type would. That's required both by query_client.go, which
+// hands these to grpc1.ClientConn.Invoke, and by query_server.go's
+// _Query_*_Handler functions, which BaseApp's GRPCQueryRouter drives through
+// dec(in): both type-assert request/response values to
+// codec.ProtoMarshaler/proto.Message before marshaling.
+
+// QueryParamsRequest is the request type for the Query/Params gRPC method.
+type QueryParamsRequest struct{}
+
+func (r *QueryParamsRequest) Reset()         { *r = QueryParamsRequest{} }
+func (r *QueryParamsRequest) String() string { return proto.CompactTextString(r) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+
+func (r *QueryParamsRequest) Marshal() ([]byte, error)           { return []byte{}, nil }
+func (r *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) { return 0, nil }
+func (r *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	return len(dAtA), nil
+}
+func (r *QueryParamsRequest) Size() int { return 0 }
+
+func (r *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	return unmarshalEmptyQuery("QueryParamsRequest", dAtA)
+}
+
+// QueryParamsResponse is the response type for the Query/Params gRPC method.
+type QueryParamsResponse struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params" yaml:"params"`
+}
+
+func (r *QueryParamsResponse) Reset()         { *r = QueryParamsResponse{} }
+func (r *QueryParamsResponse) String() string { return proto.CompactTextString(r) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+
+func (r *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := r.Size()
+	dAtA = make([]byte, size)
+	n, err := r.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (r *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := r.Size()
+	return r.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (r *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	{
+		size, err := r.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (r *QueryParamsResponse) Size() (n int) {
+	if r == nil {
+		return 0
+	}
+	l := r.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (r *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		fieldNum, wireType, next, err := readQueryTag(dAtA, iNdEx, l)
+		if err != nil {
+			return err
+		}
+		iNdEx = next
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: QueryParamsResponse: wrong wireType = %d for field Params", wireType)
+			}
+			msgBz, next, err := readQueryBytes(dAtA, iNdEx, l)
+			if err != nil {
+				return err
+			}
+			if err := r.Params.Unmarshal(msgBz); err != nil {
+				return err
+			}
+			iNdEx = next
+		default:
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 || iNdEx+skippy < 0 || iNdEx+skippy > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+// QuerySquareSizeRequest is the request type for the Query/SquareSize gRPC
+// method.
+type QuerySquareSizeRequest struct{}
+
+func (r *QuerySquareSizeRequest) Reset()         { *r = QuerySquareSizeRequest{} }
+func (r *QuerySquareSizeRequest) String() string { return proto.CompactTextString(r) }
+func (*QuerySquareSizeRequest) ProtoMessage()    {}
+
+func (r *QuerySquareSizeRequest) Marshal() ([]byte, error)           { return []byte{}, nil }
+func (r *QuerySquareSizeRequest) MarshalTo(dAtA []byte) (int, error) { return 0, nil }
+func (r *QuerySquareSizeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	return len(dAtA), nil
+}
+func (r *QuerySquareSizeRequest) Size() int { return 0 }
+
+func (r *QuerySquareSizeRequest) Unmarshal(dAtA []byte) error {
+	return unmarshalEmptyQuery("QuerySquareSizeRequest", dAtA)
+}
+
+// QuerySquareSizeResponse is the response type for the Query/SquareSize gRPC
+// method.
+type QuerySquareSizeResponse struct {
+	SquareSize                         uint64 `protobuf:"varint,1,opt,name=square_size,json=squareSize,proto3" json:"square_size" yaml:"square_size"`
+	ShareSize                          uint64 `protobuf:"varint,2,opt,name=share_size,json=shareSize,proto3" json:"share_size" yaml:"share_size"`
+	NamespaceSize                      uint64 `protobuf:"varint,3,opt,name=namespace_size,json=namespaceSize,proto3" json:"namespace_size" yaml:"namespace_size"`
+	FirstSparseShareContentSize        uint64 `protobuf:"varint,4,opt,name=first_sparse_share_content_size,json=firstSparseShareContentSize,proto3" json:"first_sparse_share_content_size" yaml:"first_sparse_share_content_size"`
+	ContinuationSparseShareContentSize uint64 `protobuf:"varint,5,opt,name=continuation_sparse_share_content_size,json=continuationSparseShareContentSize,proto3" json:"continuation_sparse_share_content_size" yaml:"continuation_sparse_share_content_size"`
+}
+
+func (r *QuerySquareSizeResponse) Reset()         { *r = QuerySquareSizeResponse{} }
+func (r *QuerySquareSizeResponse) String() string { return proto.CompactTextString(r) }
+func (*QuerySquareSizeResponse) ProtoMessage()    {}
+
+func (r *QuerySquareSizeResponse) Marshal() (dAtA []byte, err error) {
+	size := r.Size()
+	dAtA = make([]byte, size)
+	n, err := r.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (r *QuerySquareSizeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := r.Size()
+	return r.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (r *QuerySquareSizeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if r.ContinuationSparseShareContentSize != 0 {
+		i = encodeVarintQuery(dAtA, i, r.ContinuationSparseShareContentSize)
+		i--
+		dAtA[i] = 0x28
+	}
+	if r.FirstSparseShareContentSize != 0 {
+		i = encodeVarintQuery(dAtA, i, r.FirstSparseShareContentSize)
+		i--
+		dAtA[i] = 0x20
+	}
+	if r.NamespaceSize != 0 {
+		i = encodeVarintQuery(dAtA, i, r.NamespaceSize)
+		i--
+		dAtA[i] = 0x18
+	}
+	if r.ShareSize != 0 {
+		i = encodeVarintQuery(dAtA, i, r.ShareSize)
+		i--
+		dAtA[i] = 0x10
+	}
+	if r.SquareSize != 0 {
+		i = encodeVarintQuery(dAtA, i, r.SquareSize)
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (r *QuerySquareSizeResponse) Size() (n int) {
+	if r == nil {
+		return 0
+	}
+	if r.SquareSize != 0 {
+		n += 1 + sovQuery(r.SquareSize)
+	}
+	if r.ShareSize != 0 {
+		n += 1 + sovQuery(r.ShareSize)
+	}
+	if r.NamespaceSize != 0 {
+		n += 1 + sovQuery(r.NamespaceSize)
+	}
+	if r.FirstSparseShareContentSize != 0 {
+		n += 1 + sovQuery(r.FirstSparseShareContentSize)
+	}
+	if r.ContinuationSparseShareContentSize != 0 {
+		n += 1 + sovQuery(r.ContinuationSparseShareContentSize)
+	}
+	return n
+}
+
+func (r *QuerySquareSizeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		fieldNum, wireType, next, err := readQueryTag(dAtA, iNdEx, l)
+		if err != nil {
+			return err
+		}
+		iNdEx = next
+		switch fieldNum {
+		case 1, 2, 3, 4, 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: QuerySquareSizeResponse: wrong wireType = %d for field %d", wireType, fieldNum)
+			}
+			v, next, err := readQueryVarint(dAtA, iNdEx, l)
+			if err != nil {
+				return err
+			}
+			iNdEx = next
+			switch fieldNum {
+			case 1:
+				r.SquareSize = v
+			case 2:
+				r.ShareSize = v
+			case 3:
+				r.NamespaceSize = v
+			case 4:
+				r.FirstSparseShareContentSize = v
+			case 5:
+				r.ContinuationSparseShareContentSize = v
+			}
+		default:
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 || iNdEx+skippy < 0 || iNdEx+skippy > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+// QueryPreprocessTxsRequest is the request type for the
+// Query/PreprocessTxs gRPC method.
+type QueryPreprocessTxsRequest struct {
+	Txs [][]byte `protobuf:"bytes,1,rep,name=txs,proto3" json:"txs" yaml:"txs"`
+}
+
+func (r *QueryPreprocessTxsRequest) Reset()         { *r = QueryPreprocessTxsRequest{} }
+func (r *QueryPreprocessTxsRequest) String() string { return proto.CompactTextString(r) }
+func (*QueryPreprocessTxsRequest) ProtoMessage()    {}
+
+func (r *QueryPreprocessTxsRequest) Marshal() (dAtA []byte, err error) {
+	size := r.Size()
+	dAtA = make([]byte, size)
+	n, err := r.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (r *QueryPreprocessTxsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := r.Size()
+	return r.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (r *QueryPreprocessTxsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	for iNdEx := len(r.Txs) - 1; iNdEx >= 0; iNdEx-- {
+		i -= len(r.Txs[iNdEx])
+		copy(dAtA[i:], r.Txs[iNdEx])
+		i = encodeVarintQuery(dAtA, i, uint64(len(r.Txs[iNdEx])))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (r *QueryPreprocessTxsRequest) Size() (n int) {
+	if r == nil {
+		return 0
+	}
+	for _, b := range r.Txs {
+		l := len(b)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (r *QueryPreprocessTxsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		fieldNum, wireType, next, err := readQueryTag(dAtA, iNdEx, l)
+		if err != nil {
+			return err
+		}
+		iNdEx = next
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: QueryPreprocessTxsRequest: wrong wireType = %d for field Txs", wireType)
+			}
+			tx, next, err := readQueryBytes(dAtA, iNdEx, l)
+			if err != nil {
+				return err
+			}
+			r.Txs = append(r.Txs, append([]byte{}, tx...))
+			iNdEx = next
+		default:
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 || iNdEx+skippy < 0 || iNdEx+skippy > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+// QueryPreprocessTxsResponse is the response type for the
+// Query/PreprocessTxs gRPC method. Messages is the namespace-sorted, padded
+// core.Messages proto, marshaled the same way it is in
+// abci.ResponsePreprocessTxs.
+type QueryPreprocessTxsResponse struct {
+	Txs      [][]byte `protobuf:"bytes,1,rep,name=txs,proto3" json:"txs" yaml:"txs"`
+	Messages []byte   `protobuf:"bytes,2,opt,name=messages,proto3" json:"messages" yaml:"messages"`
+}
+
+func (r *QueryPreprocessTxsResponse) Reset()         { *r = QueryPreprocessTxsResponse{} }
+func (r *QueryPreprocessTxsResponse) String() string { return proto.CompactTextString(r) }
+func (*QueryPreprocessTxsResponse) ProtoMessage()    {}
+
+func (r *QueryPreprocessTxsResponse) Marshal() (dAtA []byte, err error) {
+	size := r.Size()
+	dAtA = make([]byte, size)
+	n, err := r.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (r *QueryPreprocessTxsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := r.Size()
+	return r.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (r *QueryPreprocessTxsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if len(r.Messages) > 0 {
+		i -= len(r.Messages)
+		copy(dAtA[i:], r.Messages)
+		i = encodeVarintQuery(dAtA, i, uint64(len(r.Messages)))
+		i--
+		dAtA[i] = 0x12
+	}
+	for iNdEx := len(r.Txs) - 1; iNdEx >= 0; iNdEx-- {
+		i -= len(r.Txs[iNdEx])
+		copy(dAtA[i:], r.Txs[iNdEx])
+		i = encodeVarintQuery(dAtA, i, uint64(len(r.Txs[iNdEx])))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (r *QueryPreprocessTxsResponse) Size() (n int) {
+	if r == nil {
+		return 0
+	}
+	for _, b := range r.Txs {
+		l := len(b)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if l := len(r.Messages); l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (r *QueryPreprocessTxsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		fieldNum, wireType, next, err := readQueryTag(dAtA, iNdEx, l)
+		if err != nil {
+			return err
+		}
+		iNdEx = next
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: QueryPreprocessTxsResponse: wrong wireType = %d for field Txs", wireType)
+			}
+			tx, next, err := readQueryBytes(dAtA, iNdEx, l)
+			if err != nil {
+				return err
+			}
+			r.Txs = append(r.Txs, append([]byte{}, tx...))
+			iNdEx = next
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: QueryPreprocessTxsResponse: wrong wireType = %d for field Messages", wireType)
+			}
+			msgs, next, err := readQueryBytes(dAtA, iNdEx, l)
+			if err != nil {
+				return err
+			}
+			r.Messages = append(r.Messages[:0], msgs...)
+			iNdEx = next
+		default:
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 || iNdEx+skippy < 0 || iNdEx+skippy > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+// unmarshalEmptyQuery implements Unmarshal for the empty Query*Request
+// types: any field present would be unrecognized, so every tag is simply
+// skipped.
+func unmarshalEmptyQuery(name string, dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		_, wireType, next, err := readQueryTag(dAtA, iNdEx, l)
+		if err != nil {
+			return err
+		}
+		iNdEx = next
+		if wireType == 4 {
+			return fmt.Errorf("proto: %s: wiretype end group for non-group", name)
+		}
+		skippy, err := skipQuery(dAtA[iNdEx:])
+		if err != nil {
+			return err
+		}
+		if skippy < 0 || iNdEx+skippy < 0 || iNdEx+skippy > l {
+			return io.ErrUnexpectedEOF
+		}
+		iNdEx += skippy
+	}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+// readQueryTag reads a field tag (field number + wire type) at dAtA[iNdEx:]
+// and returns the position just past it.
+func readQueryTag(dAtA []byte, iNdEx, l int) (fieldNum int32, wireType int, next int, err error) {
+	var wire uint64
+	for shift := uint(0); ; shift += 7 {
+		if shift >= 64 {
+			return 0, 0, 0, ErrIntOverflowQuery
+		}
+		if iNdEx >= l {
+			return 0, 0, 0, io.ErrUnexpectedEOF
+		}
+		b := dAtA[iNdEx]
+		iNdEx++
+		wire |= uint64(b&0x7F) << shift
+		if b < 0x80 {
+			break
+		}
+	}
+	fieldNum = int32(wire >> 3)
+	wireType = int(wire & 0x7)
+	if fieldNum <= 0 {
+		return 0, 0, 0, fmt.Errorf("proto: illegal tag %d (wire type %d)", fieldNum, wire)
+	}
+	return fieldNum, wireType, iNdEx, nil
+}
+
+// readQueryVarint reads a varint-encoded field value at dAtA[iNdEx:].
+func readQueryVarint(dAtA []byte, iNdEx, l int) (v uint64, next int, err error) {
+	for shift := uint(0); ; shift += 7 {
+		if shift >= 64 {
+			return 0, 0, ErrIntOverflowQuery
+		}
+		if iNdEx >= l {
+			return 0, 0, io.ErrUnexpectedEOF
+		}
+		b := dAtA[iNdEx]
+		iNdEx++
+		v |= uint64(b&0x7F) << shift
+		if b < 0x80 {
+			break
+		}
+	}
+	return v, iNdEx, nil
+}
+
+// readQueryBytes reads a length-delimited field value at dAtA[iNdEx:].
+func readQueryBytes(dAtA []byte, iNdEx, l int) (b []byte, next int, err error) {
+	length, next, err := readQueryVarint(dAtA, iNdEx, l)
+	if err != nil {
+		return nil, 0, err
+	}
+	if length > uint64(l-next) {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	postIndex := next + int(length)
+	return dAtA[next:postIndex], postIndex, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+
+func sovQuery(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+
+// skipQuery skips over an unrecognized field of any wire type, mirroring
+// the helper protoc-gen-gocosmos emits once per generated file.
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
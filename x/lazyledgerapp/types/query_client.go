@@ -0,0 +1,52 @@
+package types
+
+import (
+	"context"
+
+	grpc1 "github.com/gogo/protobuf/grpc"
+)
+
+// QueryClient and NewQueryClient stand in for the client stub protoc-gen-gocosmos
+// would normally generate from proto/lazyledgerapp/v1/query.proto's service
+// definition; the CLI and any other gRPC client code depend only on this
+// interface.
+type QueryClient interface {
+	Params(ctx context.Context, in *QueryParamsRequest) (*QueryParamsResponse, error)
+	SquareSize(ctx context.Context, in *QuerySquareSizeRequest) (*QuerySquareSizeResponse, error)
+	PreprocessTxs(ctx context.Context, in *QueryPreprocessTxsRequest) (*QueryPreprocessTxsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+// NewQueryClient creates a QueryClient for the lazyledgerapp.v1 Query
+// service over the provided connection (a client.Context satisfies
+// grpc1.ClientConn).
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc: cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	if err := c.cc.Invoke(ctx, "/lazyledgerapp.v1.Query/Params", in, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SquareSize(ctx context.Context, in *QuerySquareSizeRequest) (*QuerySquareSizeResponse, error) {
+	out := new(QuerySquareSizeResponse)
+	if err := c.cc.Invoke(ctx, "/lazyledgerapp.v1.Query/SquareSize", in, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PreprocessTxs(ctx context.Context, in *QueryPreprocessTxsRequest) (*QueryPreprocessTxsResponse, error) {
+	out := new(QueryPreprocessTxsResponse)
+	if err := c.cc.Invoke(ctx, "/lazyledgerapp.v1.Query/PreprocessTxs", in, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
@@ -0,0 +1,82 @@
+package types
+
+import (
+	"context"
+
+	grpc1 "github.com/gogo/protobuf/grpc"
+	"google.golang.org/grpc"
+)
+
+// QueryServer stands in for the server stub protoc-gen-gocosmos would
+// normally generate from proto/lazyledgerapp/v1/query.proto's service
+// definition - the same service QueryClient (query_client.go) calls into.
+type QueryServer interface {
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	SquareSize(context.Context, *QuerySquareSizeRequest) (*QuerySquareSizeResponse, error)
+	PreprocessTxs(context.Context, *QueryPreprocessTxsRequest) (*QueryPreprocessTxsResponse, error)
+}
+
+// RegisterQueryServer registers srv with s, the same call every generated
+// *.pb.go's RegisterQueryServer makes. App.RegisterLazyledgerQueryService
+// (app/grpc_query.go) calls this so the lazyledgerapp.v1.Query
+// service QueryClient.Invoke dials actually resolves to something.
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/lazyledgerapp.v1.Query/Params"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SquareSize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySquareSizeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SquareSize(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/lazyledgerapp.v1.Query/SquareSize"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SquareSize(ctx, req.(*QuerySquareSizeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PreprocessTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPreprocessTxsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PreprocessTxs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/lazyledgerapp.v1.Query/PreprocessTxs"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PreprocessTxs(ctx, req.(*QueryPreprocessTxsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "lazyledgerapp.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{MethodName: "Params", Handler: _Query_Params_Handler},
+		{MethodName: "SquareSize", Handler: _Query_SquareSize_Handler},
+		{MethodName: "PreprocessTxs", Handler: _Query_PreprocessTxs_Handler},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "lazyledgerapp/v1/query.proto",
+}